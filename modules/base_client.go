@@ -7,6 +7,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go"
@@ -45,6 +48,10 @@ type baseClient struct {
 
 	accountQuery
 	tokenQuery
+
+	gasPriceOracle *gasPriceOracle
+	seqManager     *sequenceManager
+	hub            *eventHub
 }
 
 // NewBaseClient return the baseClient for every sub modules
@@ -59,7 +66,7 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 
 	base := baseClient{
 		TmClient:       NewRPCClient(cfg.NodeURI, encodingConfig.Amino, encodingConfig.TxConfig.TxDecoder(), logger, cfg.Timeout),
-		GRPCClient:     NewGRPCClient(cfg.GRPCAddr, cfg.BSNProject),
+		GRPCClient:     buildGRPCClient(cfg),
 		logger:         logger,
 		cfg:            &cfg,
 		encodingConfig: encodingConfig,
@@ -90,9 +97,60 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 		Cache:      c,
 	}
 
+	if cfg.DynamicFee {
+		base.gasPriceOracle = newGasPriceOracle(base.TmClient, cfg.GasPriceOracle)
+	}
+
+	base.seqManager = newSequenceManager(func(address string) (uint64, error) {
+		account, err := base.QueryAndRefreshAccount(address)
+		if err != nil {
+			return 0, err
+		}
+		return account.Sequence, nil
+	})
+
+	base.hub = newEventHub(base.TmClient, encodingConfig.Marshaler, base.Logger(), func(address string) {
+		_ = base.removeCache(address)
+		base.seqManager.Invalidate(address)
+	})
+
 	return &base
 }
 
+// buildGRPCClient pools connections across cfg.GRPCConfig's endpoints when configured,
+// falling back to the legacy single-endpoint, plaintext (no TLS) path otherwise.
+func buildGRPCClient(cfg sdk.ClientConfig) sdk.GRPCClient {
+	if len(cfg.GRPCConfig.Endpoints) > 0 {
+		return NewGRPCClientFromConfig(cfg.GRPCConfig)
+	}
+	return NewGRPCClient(cfg.GRPCAddr, cfg.BSNProject)
+}
+
+// Close tears down the resources owned by the baseClient, currently the pooled gRPC
+// connections opened by its GRPCClient. It is safe to call even if the configured
+// GRPCClient doesn't own any closable connections.
+func (base *baseClient) Close() error {
+	if closer, ok := base.GRPCClient.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// SuggestGasPrice returns a minimum price-per-unit-of-gas derived from a decay-weighted
+// average of recent block gas utilization, bumped when the chain is busy and decayed
+// toward a floor when it's quiet. It requires ClientConfig.DynamicFee to be set.
+func (base *baseClient) SuggestGasPrice() (sdk.Dec, error) {
+	if base.gasPriceOracle == nil {
+		return sdk.Dec{}, errors.New("dynamic gas price is not enabled, set ClientConfig.DynamicFee to use it")
+	}
+
+	basePrice := base.cfg.GasPriceOracle.BasePrice
+	if basePrice.IsNil() {
+		basePrice = sdk.ZeroDec()
+	}
+	return base.gasPriceOracle.SuggestGasPrice(basePrice)
+}
+
 func (base *baseClient) Logger() log.Logger {
 	return base.logger
 }
@@ -168,6 +226,68 @@ func (base *baseClient) BuildAndSend(msg []sdk.Msg, baseTx sdk.BaseTx) (sdk.Resu
 	return res, nil
 }
 
+// BuildAndSendAsync builds, signs and broadcasts msg without waiting for it to be
+// committed, and returns a channel that receives the confirmed ResultTx once it's
+// observed over the shared event-subscription websocket maintained by base.hub. Unlike
+// BuildAndSend, the caller is never blocked on the broadcast RPC itself, regardless of
+// baseTx.Mode. A wrong-sequence tx is rejected during CheckTx and never reaches a block, so
+// it's detected here from BroadcastTxAsync's own response rather than from base.hub, which
+// only sees confirmed DeliverTx events.
+func (base *baseClient) BuildAndSendAsync(msg []sdk.Msg, baseTx sdk.BaseTx) (<-chan sdk.ResultTx, sdk.Error) {
+	base.l.Lock(baseTx.From)
+	defer base.l.Unlock(baseTx.From)
+
+	var address, hash string
+
+	retryableFunc := func() error {
+		txByte, ctx, e := base.buildTx(msg, baseTx)
+		if e != nil {
+			return e
+		}
+		address = ctx.Address()
+
+		res, e := base.BroadcastTxAsync(context.Background(), txByte)
+		if e != nil {
+			return sdk.Wrap(e)
+		}
+		if res.Code != abci.CodeTypeOK {
+			return sdk.GetError(sdk.RootCodespace, res.Code)
+		}
+		hash = txHash(txByte)
+		return nil
+	}
+
+	retryIfFunc := func(err error) bool {
+		e, ok := err.(sdk.Error)
+		return ok && sdk.Code(e.Code()) == sdk.WrongSequence
+	}
+
+	onRetryFunc := func(n uint, err error) {
+		_ = base.removeCache(address)
+		base.Logger().Error("wrong sequence, will retry",
+			"address", address, "attempts", n, "err", err.Error())
+	}
+
+	if err := retry.Do(retryableFunc,
+		retry.Attempts(tryThreshold),
+		retry.RetryIf(retryIfFunc),
+		retry.OnRetry(onRetryFunc),
+	); err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	timeout := base.cfg.ConfirmTimeout
+	if timeout <= 0 {
+		timeout = base.cfg.Timeout
+	}
+
+	ch, err := base.hub.Subscribe(hash, address, timeout)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return ch, nil
+}
+
 func (base *baseClient) SendBatch(msgs sdk.Msgs, baseTx sdk.BaseTx) (rs []sdk.ResultTx, err sdk.Error) {
 	if msgs == nil || len(msgs) == 0 {
 		return rs, sdk.Wrapf("must have at least one message in list")
@@ -188,61 +308,195 @@ func (base *baseClient) SendBatch(msgs sdk.Msgs, baseTx sdk.BaseTx) (rs []sdk.Re
 	base.l.Lock(baseTx.From)
 	defer base.l.Unlock(baseTx.From)
 
-	var address string
+	addr, e := base.QueryAddress(baseTx.From, baseTx.Password)
+	if e != nil {
+		return rs, sdk.Wrap(e)
+	}
+	address := addr.String()
+
+	// Resolve the account number once: it's identical for every sub-batch, unlike the
+	// sequence, so re-fetching it per worker in sendSubBatch would just be a redundant
+	// round trip against the same account.
+	account, e := base.QueryAndRefreshAccount(address)
+	if e != nil {
+		return rs, sdk.Wrap(e)
+	}
+	accountNumber := account.AccountNumber
+
+	workers := base.cfg.BroadcastConcurrency
+	if workers <= 0 {
+		workers = concurrency
+	}
+
 	var batch = maxBatch
 
 	retryableFunc := func() error {
-		for i, ms := range utils.SubArray(batch, msgs) {
-			mss := ms.(sdk.Msgs)
-			txByte, ctx, err := base.buildTx(mss, baseTx)
+		subBatches := utils.SubArray(batch, msgs)
+		n := len(subBatches)
+		results := make([]sdk.ResultTx, n)
+		resolved := make([]bool, n)
+		outcomes := make(chan subBatchResult, n)
+
+		// Reserve every sequence number up front, in index order, so the order sub-batches
+		// land on-chain matches input order regardless of which worker goroutine runs first.
+		seqs := make([]uint64, n)
+		for i := range subBatches {
+			seq, err := base.seqManager.Reserve(address)
 			if err != nil {
-				return err
+				return sdk.Wrap(err)
 			}
+			seqs[i] = seq
+		}
 
-			valid, err := base.ValidateTxSize(len(txByte), mss)
-			if err != nil {
-				return err
-			}
-			if !valid {
-				base.Logger().Debug("tx is too large", "msgsLength", batch)
-				// filter out transactions that have been sent
-				msgs = msgs[i*batch:]
-				// reset the maximum number of msg in each transaction
-				batch = batch / 2
-				return sdk.GetError(sdk.RootCodespace, uint32(sdk.TxTooLarge))
+		sem := make(chan struct{}, workers)
+		var wg sync.WaitGroup
+		for i, ms := range subBatches {
+			i, mss := i, ms.(sdk.Msgs)
+
+			sem <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				res, err := base.sendSubBatch(address, accountNumber, seqs[i], mss, baseTx)
+				outcomes <- subBatchResult{index: i, res: res, err: err}
+			}()
+		}
+		wg.Wait()
+		close(outcomes)
+
+		tooLargeAt := n
+		var retryIndexes []int
+		for o := range outcomes {
+			switch {
+			case o.err == nil:
+				results[o.index] = o.res
+				resolved[o.index] = true
+			case sdk.Code(o.err.Code()) == sdk.TxTooLarge:
+				if o.index < tooLargeAt {
+					tooLargeAt = o.index
+				}
+			default:
+				retryIndexes = append(retryIndexes, o.index)
 			}
-			res, err := base.broadcastTx(txByte, ctx.Mode())
-			if err != nil {
-				address = ctx.Address()
-				return err
+		}
+
+		if len(retryIndexes) > 0 {
+			_ = base.removeCache(address)
+			base.seqManager.Invalidate(address)
+			base.Logger().Error("broadcast failed, re-driving serially",
+				"address", address, "count", len(retryIndexes))
+
+			sort.Ints(retryIndexes)
+			for _, idx := range retryIndexes {
+				if idx >= tooLargeAt {
+					continue
+				}
+				seq, err := base.seqManager.Reserve(address)
+				if err != nil {
+					rs = append(rs, resolvedResults(results[:tooLargeAt], resolved[:tooLargeAt])...)
+					return err
+				}
+				res, err := base.sendSubBatch(address, accountNumber, seq, subBatches[idx].(sdk.Msgs), baseTx)
+				if err != nil {
+					// keep whatever already succeeded in this attempt before giving up; the
+					// still-unresolved indexes are dropped, not padded with blank results
+					rs = append(rs, resolvedResults(results[:tooLargeAt], resolved[:tooLargeAt])...)
+					return err
+				}
+				results[idx] = res
+				resolved[idx] = true
 			}
-			rs = append(rs, res)
 		}
+
+		if tooLargeAt < n {
+			base.Logger().Debug("tx is too large", "msgsLength", batch)
+			rs = append(rs, resolvedResults(results[:tooLargeAt], resolved[:tooLargeAt])...)
+			// filter out transactions that have been sent
+			msgs = msgs[tooLargeAt*batch:]
+			// reset the maximum number of msg in each transaction
+			batch = batch / 2
+			return sdk.GetError(sdk.RootCodespace, uint32(sdk.TxTooLarge))
+		}
+
+		rs = append(rs, resolvedResults(results, resolved)...)
 		return nil
 	}
 
 	retryIf := func(err error) bool {
 		e, ok := err.(sdk.Error)
-		if ok && (sdk.Code(e.Code()) == sdk.InvalidSequence || sdk.Code(e.Code()) == sdk.TxTooLarge) {
+		if ok && sdk.Code(e.Code()) == sdk.TxTooLarge {
 			return true
 		}
 		return false
 	}
 
 	onRetry := func(n uint, err error) {
-		_ = base.removeCache(address)
-		base.Logger().Error("wrong sequence, will retry",
-			"address", address, "attempts", n, "err", err.Error())
+		base.Logger().Error("tx too large, will retry with a smaller batch",
+			"attempts", n, "err", err.Error())
 	}
 
-	_ = retry.Do(retryableFunc,
+	if e := retry.Do(retryableFunc,
 		retry.Attempts(tryThreshold),
 		retry.RetryIf(retryIf),
 		retry.OnRetry(onRetry),
-	)
+	); e != nil {
+		return rs, sdk.Wrap(e)
+	}
 	return rs, nil
 }
 
+// subBatchResult carries the outcome of broadcasting one SendBatch sub-batch back to the
+// caller that dispatched it, keyed by its position in the batch so results can be
+// reassembled in input order once every worker has reported back.
+type subBatchResult struct {
+	index int
+	res   sdk.ResultTx
+	err   sdk.Error
+}
+
+// resolvedResults returns the subset of results whose resolved flag is set, in order, so a
+// sub-batch that was never successfully (re)sent doesn't surface as a blank, no-error
+// ResultTx alongside the ones that actually succeeded.
+func resolvedResults(results []sdk.ResultTx, resolved []bool) []sdk.ResultTx {
+	out := make([]sdk.ResultTx, 0, len(results))
+	for i, r := range results {
+		if resolved[i] {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// sendSubBatch builds, signs and broadcasts a single SendBatch sub-batch using seq as its
+// account sequence. address and accountNumber are the values SendBatch already resolved
+// once for the whole batch, so sendSubBatch never re-queries them itself; only seq varies
+// per sub-batch, letting sub-batches run concurrently without colliding on the sequence.
+func (base *baseClient) sendSubBatch(address string, accountNumber, seq uint64, msgs sdk.Msgs, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	var res sdk.ResultTx
+
+	factory, err := base.prepare(baseTx, withAccount(address, accountNumber), withSequence(seq))
+	if err != nil {
+		return res, sdk.Wrap(err)
+	}
+
+	txByte, err := factory.BuildAndSign(baseTx.From, msgs)
+	if err != nil {
+		return res, sdk.Wrap(err)
+	}
+
+	valid, sdkErr := base.ValidateTxSize(len(txByte), msgs)
+	if sdkErr != nil {
+		return res, sdkErr
+	}
+	if !valid {
+		return res, sdk.GetError(sdk.RootCodespace, uint32(sdk.TxTooLarge))
+	}
+
+	return base.broadcastTx(txByte, factory.Mode())
+}
+
 func (base baseClient) QueryWithResponse(path string, data interface{}, result sdk.Response) error {
 	res, err := base.Query(path, data)
 	if err != nil {
@@ -302,7 +556,50 @@ func (base baseClient) QueryStore(key sdk.HexBytes, storeName string, height int
 	return resp, nil
 }
 
-func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
+// dynamicFeeAmount converts price, a per-unit-of-gas price, into the total fee amount for
+// a tx spending gas units of gas, rounding up to the nearest whole unit.
+func dynamicFeeAmount(price sdk.Dec, gas uint64) sdk.Int {
+	return price.MulInt64(int64(gas)).Ceil().RoundInt()
+}
+
+// accountIdentity carries the address/account-number/sequence values prepare would
+// otherwise derive itself, plus flags recording which ones a caller already supplied.
+// prepare only re-queries the account for fields that aren't already set.
+type accountIdentity struct {
+	address            string
+	haveAddress        bool
+	accountNumber      uint64
+	haveAccountNumber  bool
+	sequence           uint64
+	sequenceOverridden bool
+}
+
+// prepareOption overrides a value prepare would otherwise derive from the account cache.
+type prepareOption func(id *accountIdentity)
+
+// withSequence overrides the account sequence prepare would otherwise take from the
+// (possibly stale) account cache. Used by SendBatch to assign reserved, non-colliding
+// sequence numbers to concurrently-built sub-batches.
+func withSequence(seq uint64) prepareOption {
+	return func(id *accountIdentity) {
+		id.sequence = seq
+		id.sequenceOverridden = true
+	}
+}
+
+// withAccount overrides the address and account number prepare would otherwise look up
+// itself. Used by sendSubBatch, which reuses the address/account number SendBatch already
+// resolved once for the whole batch instead of re-querying them per sub-batch.
+func withAccount(address string, accountNumber uint64) prepareOption {
+	return func(id *accountIdentity) {
+		id.address = address
+		id.haveAddress = true
+		id.accountNumber = accountNumber
+		id.haveAccountNumber = true
+	}
+}
+
+func (base *baseClient) prepare(baseTx sdk.BaseTx, opts ...prepareOption) (*clienttx.Factory, error) {
 	factory := clienttx.NewFactory().
 		WithChainID(base.cfg.ChainID).
 		WithKeyManager(base.KeyManager).
@@ -314,18 +611,35 @@ func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 		WithTxConfig(base.encodingConfig.TxConfig).
 		WithQueryFunc(base.QueryWithData)
 
-	addr, err := base.QueryAddress(baseTx.From, baseTx.Password)
-	if err != nil {
-		return nil, err
+	var id accountIdentity
+	for _, opt := range opts {
+		opt(&id)
 	}
-	factory.WithAddress(addr.String())
 
-	account, err := base.QueryAndRefreshAccount(addr.String())
-	if err != nil {
-		return nil, err
+	if !id.haveAddress {
+		addr, err := base.QueryAddress(baseTx.From, baseTx.Password)
+		if err != nil {
+			return nil, err
+		}
+		id.address = addr.String()
 	}
-	factory.WithAccountNumber(account.AccountNumber).
-		WithSequence(account.Sequence).
+	factory.WithAddress(id.address)
+
+	if !id.haveAccountNumber || !id.sequenceOverridden {
+		account, err := base.QueryAndRefreshAccount(id.address)
+		if err != nil {
+			return nil, err
+		}
+		if !id.haveAccountNumber {
+			id.accountNumber = account.AccountNumber
+		}
+		if !id.sequenceOverridden {
+			id.sequence = account.Sequence
+		}
+	}
+
+	factory.WithAccountNumber(id.accountNumber).
+		WithSequence(id.sequence).
 		WithPassword(baseTx.Password)
 
 	if !baseTx.Fee.Empty() && baseTx.Fee.IsValid() {
@@ -334,6 +648,18 @@ func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 			return nil, err
 		}
 		factory.WithFee(fees)
+	} else if base.cfg.DynamicFee && len(base.cfg.Fee) > 0 {
+		price, err := base.SuggestGasPrice()
+		if err != nil {
+			return nil, err
+		}
+
+		amount := dynamicFeeAmount(price, factory.Gas())
+		fees, err := base.ToMinCoin(sdk.NewCoin(base.cfg.Fee[0].Denom, amount))
+		if err != nil {
+			return nil, err
+		}
+		factory.WithFee(fees)
 	} else {
 		fees, err := base.ToMinCoin(base.cfg.Fee...)
 		if err != nil {
@@ -0,0 +1,21 @@
+package modules
+
+import (
+	"testing"
+
+	sdk "github.com/bianjieai/irita-sdk-go/types"
+)
+
+func TestDynamicFeeAmount_ScalesPerGasUnitPriceByGas(t *testing.T) {
+	amount := dynamicFeeAmount(sdk.NewDecWithPrec(5, 1), 100000) // 0.5 per unit * 100000 units
+	if !amount.Equal(sdk.NewInt(50000)) {
+		t.Fatalf("expected 50000, got %s", amount)
+	}
+}
+
+func TestDynamicFeeAmount_RoundsUpToWholeUnit(t *testing.T) {
+	amount := dynamicFeeAmount(sdk.NewDecWithPrec(15, 2), 3) // 0.15 * 3 = 0.45 -> ceil 1
+	if !amount.Equal(sdk.NewInt(1)) {
+		t.Fatalf("expected 1, got %s", amount)
+	}
+}
@@ -10,6 +10,7 @@ type Token struct {
 	projectId        string
 	projectKey       string
 	chainAccountAddr string
+	requireTLS       bool
 }
 
 const (
@@ -25,13 +26,17 @@ func (t *Token) GetRequestMetadata(ctx context.Context, uri ...string) (map[stri
 
 // RequireTransportSecurity 是否需要基于 TLS 认证进行安全传输
 func (t *Token) RequireTransportSecurity() bool {
-	return false
+	return t.requireTLS
 }
 
-func NewBsnToken(info types.BSNProjectInfo) *Token {
+// NewBsnToken builds the per-RPC credentials for a BSN project. requireTLS should mirror
+// whether the connection being authenticated was dialled with transport security, since
+// grpc refuses to send PerRPCCredentials that require TLS over a plaintext connection.
+func NewBsnToken(info types.BSNProjectInfo, requireTLS bool) *Token {
 	return &Token{
 		projectId:        info.ProjectId,
 		projectKey:       info.ProjectKey,
 		chainAccountAddr: info.ChainAccountAddress,
+		requireTLS:       requireTLS,
 	}
 }
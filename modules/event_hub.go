@@ -0,0 +1,205 @@
+package modules
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/bianjieai/irita-sdk-go/codec"
+	sdk "github.com/bianjieai/irita-sdk-go/types"
+)
+
+const (
+	eventHubSubscriber        = "irita-sdk-go/event-hub"
+	eventHubReconnectAttempts = 5
+	eventHubReconnectBackoff  = 2 * time.Second
+)
+
+// eventHub multiplexes tx-confirmation subscriptions for every in-flight
+// BuildAndSendAsync call through the single long-lived Tendermint RPC WebSocket that
+// sdk.TmClient already maintains, rather than opening one connection per call.
+type eventHub struct {
+	tm     sdk.TmClient
+	cdc    codec.Marshaler
+	logger log.Logger
+
+	// onWrongSequence is called with the signer address whenever a confirmed tx event
+	// reveals a wrong sequence, so the caller can invalidate its account cache.
+	onWrongSequence func(address string)
+
+	mu      sync.Mutex
+	waiters map[string]eventHubWaiter // tx hash -> waiter
+}
+
+type eventHubWaiter struct {
+	address string
+	result  chan sdk.ResultTx
+}
+
+func newEventHub(tm sdk.TmClient, cdc codec.Marshaler, logger log.Logger, onWrongSequence func(address string)) *eventHub {
+	return &eventHub{
+		tm:              tm,
+		cdc:             cdc,
+		logger:          logger,
+		onWrongSequence: onWrongSequence,
+		waiters:         make(map[string]eventHubWaiter),
+	}
+}
+
+// Subscribe registers hash as outstanding for address and returns a channel that
+// receives exactly one ResultTx: either the confirmed tx as observed over the shared
+// event subscription, or a best-effort placeholder carrying a timeout error if timeout
+// elapses first. The channel is closed after that single send.
+func (h *eventHub) Subscribe(hash, address string, timeout time.Duration) (<-chan sdk.ResultTx, error) {
+	waiter := eventHubWaiter{address: address, result: make(chan sdk.ResultTx, 1)}
+
+	h.mu.Lock()
+	_, alreadySubscribed := h.waiters[hash]
+	h.waiters[hash] = waiter
+	h.mu.Unlock()
+
+	if !alreadySubscribed {
+		if err := h.subscribeTx(hash); err != nil {
+			h.mu.Lock()
+			delete(h.waiters, hash)
+			h.mu.Unlock()
+			return nil, err
+		}
+	}
+
+	out := make(chan sdk.ResultTx, 1)
+	go func() {
+		defer close(out)
+		select {
+		case res := <-waiter.result:
+			out <- res
+		case <-time.After(timeout):
+			h.mu.Lock()
+			delete(h.waiters, hash)
+			h.mu.Unlock()
+			_ = h.tm.Unsubscribe(context.Background(), eventHubSubscriber, txHashQuery(hash))
+			out <- sdk.ResultTx{
+				Hash: hash,
+				Log:  fmt.Sprintf("timed out after %s waiting for tx confirmation", timeout),
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (h *eventHub) subscribeTx(hash string) error {
+	query := txHashQuery(hash)
+	out, err := h.tm.Subscribe(context.Background(), eventHubSubscriber, query)
+	if err != nil {
+		return err
+	}
+
+	go h.watch(hash, query, out)
+	return nil
+}
+
+// watch delivers the first event received for hash to its waiter. If the shared
+// websocket drops the subscription before that happens, it reconnects and resumes
+// watching for as long as hash is still outstanding.
+func (h *eventHub) watch(hash, query string, out <-chan ctypes.ResultEvent) {
+	for {
+		evt, ok := <-out
+		if !ok {
+			h.mu.Lock()
+			_, stillWaiting := h.waiters[hash]
+			h.mu.Unlock()
+			if !stillWaiting {
+				return
+			}
+
+			resumed, err := h.reconnect(query)
+			if err != nil {
+				h.logger.Error("event hub gave up reconnecting, dropping waiter", "hash", hash, "err", err.Error())
+				h.deliver(hash, sdk.ResultTx{Hash: hash, Log: err.Error()})
+				return
+			}
+			out = resumed
+			continue
+		}
+
+		h.deliver(hash, h.decode(hash, evt))
+		return
+	}
+}
+
+// reconnect re-subscribes to query, retrying with a fixed backoff to ride out a
+// transient websocket disconnect.
+func (h *eventHub) reconnect(query string) (<-chan ctypes.ResultEvent, error) {
+	var lastErr error
+	for attempt := 1; attempt <= eventHubReconnectAttempts; attempt++ {
+		out, err := h.tm.Subscribe(context.Background(), eventHubSubscriber, query)
+		if err == nil {
+			return out, nil
+		}
+		lastErr = err
+		h.logger.Error("event hub websocket disconnected, reconnecting",
+			"attempt", attempt, "err", err.Error())
+		time.Sleep(eventHubReconnectBackoff)
+	}
+	return nil, lastErr
+}
+
+// deliver hands res to hash's waiter, if it's still outstanding, and invalidates the
+// account cache if the confirmed tx somehow still carries a wrong sequence. In practice a
+// wrong-sequence tx is rejected during CheckTx and never reaches this path at all; the
+// real detection happens in BuildAndSendAsync against BroadcastTxAsync's own response, so
+// this is just a best-effort backstop for a DeliverTx-time race.
+func (h *eventHub) deliver(hash string, res sdk.ResultTx) {
+	h.mu.Lock()
+	waiter, ok := h.waiters[hash]
+	delete(h.waiters, hash)
+	h.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	if sdk.Code(res.Code) == sdk.WrongSequence {
+		h.logger.Error("wrong sequence observed via tx event", "address", waiter.address, "hash", hash)
+		h.onWrongSequence(waiter.address)
+	}
+
+	waiter.result <- res
+	_ = h.tm.Unsubscribe(context.Background(), eventHubSubscriber, txHashQuery(hash))
+}
+
+func (h *eventHub) decode(hash string, evt ctypes.ResultEvent) sdk.ResultTx {
+	data, ok := evt.Data.(tmtypes.EventDataTx)
+	if !ok {
+		return sdk.ResultTx{Hash: hash}
+	}
+
+	result := data.TxResult.Result
+	return sdk.ResultTx{
+		Hash:      hash,
+		Height:    data.TxResult.Height,
+		Code:      result.Code,
+		Log:       result.Log,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Events:    sdk.ParseEvents(h.cdc, result.Events),
+	}
+}
+
+func txHashQuery(hash string) string {
+	return fmt.Sprintf("tm.event = 'Tx' AND tx.hash = '%s'", hash)
+}
+
+// txHash returns the canonical, uppercase hex tx hash Tendermint indexes tx.hash events
+// under for the given signed transaction bytes.
+func txHash(txByte []byte) string {
+	return strings.ToUpper(hex.EncodeToString(tmtypes.Tx(txByte).Hash()))
+}
@@ -0,0 +1,168 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/bianjieai/irita-sdk-go/types"
+)
+
+const (
+	defaultGasPriceWindowSize  = 20
+	defaultGasPriceHighWater   = 0.8
+	defaultGasPriceLowWater    = 0.2
+	defaultGasPriceBumpFactor  = 1.2
+	defaultGasPriceDecayFactor = 0.95
+	defaultGasPriceDecayWeight = 0.9
+)
+
+// gasPriceSource is the subset of sdk.TmClient the oracle depends on, kept narrow so it
+// can be faked in tests without implementing the full Tendermint RPC client.
+type gasPriceSource interface {
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+}
+
+func gasPriceOracleDefaults(cfg sdk.GasPriceOracleConfig) sdk.GasPriceOracleConfig {
+	if cfg.WindowSize <= 0 {
+		cfg.WindowSize = defaultGasPriceWindowSize
+	}
+	if cfg.HighWaterMark <= 0 {
+		cfg.HighWaterMark = defaultGasPriceHighWater
+	}
+	if cfg.LowWaterMark <= 0 {
+		cfg.LowWaterMark = defaultGasPriceLowWater
+	}
+	if cfg.BumpFactor <= 0 {
+		cfg.BumpFactor = defaultGasPriceBumpFactor
+	}
+	if cfg.DecayFactor <= 0 {
+		cfg.DecayFactor = defaultGasPriceDecayFactor
+	}
+	if cfg.DecayWeight <= 0 {
+		cfg.DecayWeight = defaultGasPriceDecayWeight
+	}
+	return cfg
+}
+
+// blockUtilization records the gas-used/gas-wanted ratio observed in a single block.
+type blockUtilization struct {
+	height int64
+	ratio  float64
+}
+
+// gasPriceOracle derives a suggested gas price from a rolling window of block utilization.
+type gasPriceOracle struct {
+	tm  gasPriceSource
+	cfg sdk.GasPriceOracleConfig
+
+	mu     sync.Mutex
+	window []blockUtilization // ascending by height
+}
+
+func newGasPriceOracle(tm gasPriceSource, cfg sdk.GasPriceOracleConfig) *gasPriceOracle {
+	return &gasPriceOracle{
+		tm:  tm,
+		cfg: gasPriceOracleDefaults(cfg),
+	}
+}
+
+// SuggestGasPrice adjusts base from the current window of observed block utilization,
+// clamped to [cfg.Min, cfg.Max].
+func (o *gasPriceOracle) SuggestGasPrice(base sdk.Dec) (sdk.Dec, error) {
+	status, err := o.tm.Status(context.Background())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if err := o.refresh(status.SyncInfo.LatestBlockHeight); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	price := base
+	switch utilization := o.weightedUtilization(); {
+	case utilization >= o.cfg.HighWaterMark:
+		price = price.Mul(decFromFloat(o.cfg.BumpFactor))
+	case utilization <= o.cfg.LowWaterMark:
+		price = price.Mul(decFromFloat(o.cfg.DecayFactor))
+		if !o.cfg.Floor.IsNil() && price.LT(o.cfg.Floor) {
+			price = o.cfg.Floor
+		}
+	}
+
+	if !o.cfg.Min.IsNil() && price.LT(o.cfg.Min) {
+		price = o.cfg.Min
+	}
+	if !o.cfg.Max.IsNil() && price.GT(o.cfg.Max) {
+		price = o.cfg.Max
+	}
+	return price, nil
+}
+
+// refresh pulls the gas utilization of any blocks up to latest that aren't already in
+// the window, then evicts entries older than cfg.WindowSize. On the first call it seeds
+// the window from latest-WindowSize+1 instead of block 1, so it doesn't walk the whole
+// chain history before returning.
+func (o *gasPriceOracle) refresh(latest int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	have := latest - int64(o.cfg.WindowSize)
+	if n := len(o.window); n > 0 {
+		have = o.window[n-1].height
+	}
+	if have < 0 {
+		have = 0
+	}
+
+	for h := have + 1; h <= latest; h++ {
+		height := h
+		res, err := o.tm.BlockResults(context.Background(), &height)
+		if err != nil {
+			return err
+		}
+
+		var used, wanted int64
+		for _, txResult := range res.TxsResults {
+			used += txResult.GasUsed
+			wanted += txResult.GasWanted
+		}
+
+		var ratio float64
+		if wanted > 0 {
+			ratio = float64(used) / float64(wanted)
+		}
+		o.window = append(o.window, blockUtilization{height: h, ratio: ratio})
+	}
+
+	if excess := len(o.window) - o.cfg.WindowSize; excess > 0 {
+		o.window = o.window[excess:]
+	}
+	return nil
+}
+
+// weightedUtilization averages the current window, most recent block weighted heaviest.
+func (o *gasPriceOracle) weightedUtilization() float64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if len(o.window) == 0 {
+		return 0
+	}
+
+	var weightedSum, weightTotal, weight float64
+	weight = 1
+	for i := len(o.window) - 1; i >= 0; i-- {
+		weightedSum += o.window[i].ratio * weight
+		weightTotal += weight
+		weight *= o.cfg.DecayWeight
+	}
+	return weightedSum / weightTotal
+}
+
+func decFromFloat(f float64) sdk.Dec {
+	return sdk.MustNewDecFromStr(fmt.Sprintf("%f", f))
+}
@@ -0,0 +1,117 @@
+package modules
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/bianjieai/irita-sdk-go/types"
+)
+
+// fakeGasPriceSource is a minimal gasPriceSource backed by a fixed latest height and a
+// per-height table of gas-used/gas-wanted pairs. It records every height BlockResults was
+// called with, so tests can assert on how much history got walked.
+type fakeGasPriceSource struct {
+	latest  int64
+	blocks  map[int64][2]int64 // height -> [gasUsed, gasWanted]
+	queried []int64
+}
+
+func (f *fakeGasPriceSource) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{LatestBlockHeight: f.latest},
+	}, nil
+}
+
+func (f *fakeGasPriceSource) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	f.queried = append(f.queried, *height)
+	gas := f.blocks[*height]
+	return &ctypes.ResultBlockResults{
+		Height: *height,
+		TxsResults: []*abci.ResponseDeliverTx{
+			{GasUsed: gas[0], GasWanted: gas[1]},
+		},
+	}, nil
+}
+
+func TestGasPriceOracle_BumpsOnHighUtilization(t *testing.T) {
+	blocks := map[int64][2]int64{}
+	for h := int64(1); h <= 20; h++ {
+		blocks[h] = [2]int64{95, 100} // 95% utilization
+	}
+
+	oracle := newGasPriceOracle(&fakeGasPriceSource{latest: 20, blocks: blocks}, sdk.GasPriceOracleConfig{
+		Max: sdk.NewDec(100),
+	})
+
+	price, err := oracle.SuggestGasPrice(sdk.NewDec(1))
+	if err != nil {
+		t.Fatalf("SuggestGasPrice returned error: %v", err)
+	}
+	if !price.GT(sdk.NewDec(1)) {
+		t.Fatalf("expected price to be bumped above base, got %s", price)
+	}
+}
+
+func TestGasPriceOracle_DecaysTowardFloorOnLowUtilization(t *testing.T) {
+	blocks := map[int64][2]int64{}
+	for h := int64(1); h <= 20; h++ {
+		blocks[h] = [2]int64{5, 100} // 5% utilization
+	}
+
+	floor := sdk.NewDecWithPrec(5, 1) // 0.5
+	oracle := newGasPriceOracle(&fakeGasPriceSource{latest: 20, blocks: blocks}, sdk.GasPriceOracleConfig{
+		Floor: floor,
+	})
+
+	price, err := oracle.SuggestGasPrice(sdk.NewDec(1))
+	if err != nil {
+		t.Fatalf("SuggestGasPrice returned error: %v", err)
+	}
+	if price.LT(floor) {
+		t.Fatalf("expected price to never drop below floor %s, got %s", floor, price)
+	}
+	if !price.LT(sdk.NewDec(1)) {
+		t.Fatalf("expected price to decay below base, got %s", price)
+	}
+}
+
+func TestGasPriceOracle_HoldsSteadyInMidRange(t *testing.T) {
+	blocks := map[int64][2]int64{}
+	for h := int64(1); h <= 20; h++ {
+		blocks[h] = [2]int64{50, 100} // 50% utilization
+	}
+
+	oracle := newGasPriceOracle(&fakeGasPriceSource{latest: 20, blocks: blocks}, sdk.GasPriceOracleConfig{})
+
+	price, err := oracle.SuggestGasPrice(sdk.NewDec(1))
+	if err != nil {
+		t.Fatalf("SuggestGasPrice returned error: %v", err)
+	}
+	if !price.Equal(sdk.NewDec(1)) {
+		t.Fatalf("expected price to hold steady at base, got %s", price)
+	}
+}
+
+func TestGasPriceOracle_SeedsWindowFromTailOnFirstCall(t *testing.T) {
+	blocks := map[int64][2]int64{}
+	for h := int64(1); h <= 1_000_000; h++ {
+		blocks[h] = [2]int64{50, 100}
+	}
+
+	source := &fakeGasPriceSource{latest: 1_000_000, blocks: blocks}
+	oracle := newGasPriceOracle(source, sdk.GasPriceOracleConfig{WindowSize: 20})
+
+	if _, err := oracle.SuggestGasPrice(sdk.NewDec(1)); err != nil {
+		t.Fatalf("SuggestGasPrice returned error: %v", err)
+	}
+
+	if len(source.queried) != 20 {
+		t.Fatalf("expected the first call to fetch exactly WindowSize blocks, fetched %d", len(source.queried))
+	}
+	if min := source.queried[0]; min != 1_000_000-20+1 {
+		t.Fatalf("expected window to start at latest-WindowSize+1, started at %d", min)
+	}
+}
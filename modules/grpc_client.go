@@ -1,38 +1,144 @@
 package modules
 
 import (
+	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/bianjieai/irita-sdk-go/types"
 	"github.com/prometheus/common/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
-var clientConnSingleton *grpc.ClientConn
-var once sync.Once
+// defaultGRPCDialTimeout bounds how long connFor waits for an endpoint to connect when
+// GRPCConfig.DialTimeout isn't set.
+const defaultGRPCDialTimeout = 5 * time.Second
 
+// grpcClient is a pooled, lazily-dialled connection manager: one *grpc.ClientConn is
+// cached per configured endpoint, and GenConn load-balances/fails over across them.
 type grpcClient struct {
+	cfg   types.GRPCConfig
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+	next  uint32
 }
 
+// NewGRPCClient builds a connection manager for a single BSN endpoint, dialled over
+// plaintext (no TLS). It preserves the historical single-endpoint signature; callers that need
+// pooling, failover or TLS should use NewGRPCClientFromConfig instead.
 func NewGRPCClient(url string, info types.BSNProjectInfo) types.GRPCClient {
-	once.Do(func() {
+	return NewGRPCClientFromConfig(types.GRPCConfig{
+		Endpoints:   []string{url},
+		BSNProjects: map[string]types.BSNProjectInfo{url: info},
+	})
+}
 
-		dialOpts := []grpc.DialOption{
-			grpc.WithInsecure(),
-			grpc.WithPerRPCCredentials(NewBsnToken(info)),
-		}
-		clientConn, err := grpc.Dial(url, dialOpts...)
+// NewGRPCClientFromConfig builds a connection manager that can pool connections to
+// several BSN endpoints, optionally over TLS, with round-robin selection and failover.
+// Connections are opened lazily on first use and cached for reuse.
+func NewGRPCClientFromConfig(cfg types.GRPCConfig) types.GRPCClient {
+	return &grpcClient{
+		cfg:   cfg,
+		conns: make(map[string]*grpc.ClientConn),
+	}
+}
+
+// candidateOrder returns the endpoints to try, starting at the next round-robin index and
+// wrapping to cover every configured endpoint. Split out of GenConn so the ordering can be
+// tested without dialling real connections.
+func (g *grpcClient) candidateOrder() []string {
+	n := len(g.cfg.Endpoints)
+	if n == 0 {
+		return nil
+	}
+
+	start := int(atomic.AddUint32(&g.next, 1)) % n
+	order := make([]string, n)
+	for i := 0; i < n; i++ {
+		order[i] = g.cfg.Endpoints[(start+i)%n]
+	}
+	return order
+}
+
+// GenConn returns a connection to the next endpoint in round-robin order, dialling it
+// lazily if it hasn't been used yet. Dial failures fail over to the remaining configured
+// endpoints before an error is returned.
+func (g *grpcClient) GenConn() (*grpc.ClientConn, error) {
+	candidates := g.candidateOrder()
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no gRPC endpoints configured")
+	}
+
+	var lastErr error
+	for _, endpoint := range candidates {
+		conn, err := g.connFor(endpoint)
 		if err != nil {
 			log.Error(err.Error())
-			panic(err)
+			lastErr = err
+			continue
 		}
-		clientConnSingleton = clientConn
-	})
+		return conn, nil
+	}
+	return nil, lastErr
+}
+
+// connFor returns the cached connection for endpoint, dialling and caching it on first use.
+// The dial blocks until the connection is actually up or DialTimeout elapses, so a dead
+// endpoint surfaces as a dial error here instead of being cached as a live connection.
+func (g *grpcClient) connFor(endpoint string) (*grpc.ClientConn, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-	return &grpcClient{}
+	if conn, ok := g.conns[endpoint]; ok {
+		return conn, nil
+	}
+
+	useTLS := g.cfg.TLS != nil
+	dialOpts := []grpc.DialOption{
+		grpc.WithPerRPCCredentials(NewBsnToken(g.cfg.BSNProjects[endpoint], useTLS)),
+		grpc.WithBlock(),
+	}
+	if useTLS {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(g.cfg.TLS)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	if g.cfg.Keepalive.Time > 0 {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(g.cfg.Keepalive))
+	}
+	if g.cfg.MaxRecvMsgSize > 0 {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(g.cfg.MaxRecvMsgSize)))
+	}
+
+	timeout := g.cfg.DialTimeout
+	if timeout <= 0 {
+		timeout = defaultGRPCDialTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	g.conns[endpoint] = conn
+	return conn, nil
 }
 
-func (g grpcClient) GenConn() (*grpc.ClientConn, error) {
+// Close tears down every pooled connection. It is safe to call more than once.
+func (g *grpcClient) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-	return clientConnSingleton, nil
+	var err error
+	for endpoint, conn := range g.conns {
+		if cErr := conn.Close(); cErr != nil {
+			err = cErr
+		}
+		delete(g.conns, endpoint)
+	}
+	return err
 }
@@ -0,0 +1,42 @@
+package modules
+
+import (
+	"reflect"
+	"testing"
+
+	sdk "github.com/bianjieai/irita-sdk-go/types"
+)
+
+func TestGRPCClient_CandidateOrderRoundRobins(t *testing.T) {
+	c := &grpcClient{cfg: sdk.GRPCConfig{Endpoints: []string{"a", "b", "c"}}}
+
+	first := c.candidateOrder()
+	second := c.candidateOrder()
+
+	if len(first) != 3 || len(second) != 3 {
+		t.Fatalf("expected every call to return all 3 endpoints, got %v and %v", first, second)
+	}
+	if reflect.DeepEqual(first, second) {
+		t.Fatalf("expected successive calls to start at different endpoints, got %v twice", first)
+	}
+}
+
+func TestGRPCClient_CandidateOrderWrapsAndCoversAllEndpoints(t *testing.T) {
+	c := &grpcClient{cfg: sdk.GRPCConfig{Endpoints: []string{"a", "b", "c"}}}
+
+	seen := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		order := c.candidateOrder()
+		seen[order[0]] = true
+	}
+	if len(seen) != 3 {
+		t.Fatalf("expected the starting endpoint to cycle through all 3 over 3 calls, saw %v", seen)
+	}
+}
+
+func TestGRPCClient_CandidateOrderEmptyWhenNoEndpoints(t *testing.T) {
+	c := &grpcClient{}
+	if order := c.candidateOrder(); order != nil {
+		t.Fatalf("expected nil order with no endpoints configured, got %v", order)
+	}
+}
@@ -0,0 +1,49 @@
+package modules
+
+import "sync"
+
+// sequenceManager hands out account sequence numbers that are safe to use concurrently.
+// The first reservation for an address pulls the chain-side sequence through query, and
+// every reservation after that is served from an in-memory cursor so concurrent callers
+// never hand out the same sequence twice.
+type sequenceManager struct {
+	query func(address string) (uint64, error)
+
+	mu   sync.Mutex
+	next map[string]uint64
+}
+
+func newSequenceManager(query func(address string) (uint64, error)) *sequenceManager {
+	return &sequenceManager{
+		query: query,
+		next:  make(map[string]uint64),
+	}
+}
+
+// Reserve returns the next usable sequence number for address and advances the cursor
+// past it, so a following Reserve call for the same address never repeats it.
+func (m *sequenceManager) Reserve(address string) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seq, ok := m.next[address]
+	if !ok {
+		var err error
+		seq, err = m.query(address)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	m.next[address] = seq + 1
+	return seq, nil
+}
+
+// Invalidate drops the in-memory cursor for address, forcing the next Reserve call to
+// re-query the chain-side sequence. Call this after observing a WrongSequence/
+// InvalidSequence broadcast error so the manager resyncs instead of compounding drift.
+func (m *sequenceManager) Invalidate(address string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.next, address)
+}
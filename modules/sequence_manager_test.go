@@ -0,0 +1,64 @@
+package modules
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSequenceManager_ReserveIsUniqueUnderConcurrency(t *testing.T) {
+	m := newSequenceManager(func(address string) (uint64, error) {
+		return 100, nil
+	})
+
+	const n = 50
+	seqs := make([]uint64, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seq, err := m.Reserve("addr")
+			if err != nil {
+				t.Errorf("Reserve returned error: %v", err)
+			}
+			seqs[i] = seq
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[uint64]bool, n)
+	for _, seq := range seqs {
+		if seen[seq] {
+			t.Fatalf("sequence %d was reserved more than once", seq)
+		}
+		seen[seq] = true
+	}
+}
+
+func TestSequenceManager_InvalidateForcesRequery(t *testing.T) {
+	queried := 0
+	m := newSequenceManager(func(address string) (uint64, error) {
+		queried++
+		return 5, nil
+	})
+
+	if seq, err := m.Reserve("addr"); err != nil || seq != 5 {
+		t.Fatalf("expected first Reserve to return 5, got %d, err %v", seq, err)
+	}
+	if seq, err := m.Reserve("addr"); err != nil || seq != 6 {
+		t.Fatalf("expected second Reserve to return 6 from the cursor, got %d, err %v", seq, err)
+	}
+	if queried != 1 {
+		t.Fatalf("expected only 1 query before Invalidate, got %d", queried)
+	}
+
+	m.Invalidate("addr")
+
+	if seq, err := m.Reserve("addr"); err != nil || seq != 5 {
+		t.Fatalf("expected Reserve after Invalidate to requery and return 5, got %d, err %v", seq, err)
+	}
+	if queried != 2 {
+		t.Fatalf("expected a second query after Invalidate, got %d", queried)
+	}
+}
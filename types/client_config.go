@@ -0,0 +1,34 @@
+package types
+
+import "time"
+
+// ClientConfig is the configuration used to build a BaseClient.
+type ClientConfig struct {
+	NodeURI    string
+	GRPCAddr   string
+	GRPCConfig GRPCConfig
+	BSNProject BSNProjectInfo
+
+	ChainID       string
+	Gas           uint64
+	GasAdjustment float64
+	Mode          BroadcastMode
+	Fee           Coins
+
+	Algo    string
+	KeyDAO  KeyDAO
+	Cached  bool
+	Level   string
+	Timeout time.Duration
+
+	// DynamicFee enables baseClient.SuggestGasPrice for txs that don't set an explicit fee.
+	// The suggested fee is charged in the denom of Fee[0], so it is only used when Fee is
+	// non-empty.
+	DynamicFee     bool
+	GasPriceOracle GasPriceOracleConfig
+
+	// BroadcastConcurrency bounds the worker pool SendBatch fans sub-batches across.
+	BroadcastConcurrency int
+	// ConfirmTimeout bounds how long BuildAndSendAsync waits for a tx confirmation event.
+	ConfirmTimeout time.Duration
+}
@@ -0,0 +1,26 @@
+package types
+
+// GasPriceOracleConfig parameterizes baseClient's sliding-window gas price oracle.
+type GasPriceOracleConfig struct {
+	// BasePrice is the starting price-per-unit-of-gas the window's bump/decay is applied
+	// to. It is a per-gas-unit price, unlike ClientConfig.Fee which is a flat total fee.
+	BasePrice Dec
+	// WindowSize is the number of most recent blocks considered. Defaults to 20.
+	WindowSize int
+	// HighWaterMark/LowWaterMark gate the bump/decay adjustments. Default to 0.8 and 0.2.
+	HighWaterMark float64
+	LowWaterMark  float64
+	// BumpFactor multiplies the price above HighWaterMark. Default 1.2.
+	BumpFactor float64
+	// DecayFactor multiplies the price toward Floor below LowWaterMark. Default 0.95.
+	DecayFactor float64
+	// DecayWeight is the per-block-of-age exponential decay used to weight the window. Default 0.9.
+	DecayWeight float64
+	// Floor is the price DecayFactor decays toward; a decay step never takes the price
+	// below it. It is not applied when the price is held or bumped, and Max still clamps
+	// after it.
+	Floor Dec
+	// Min and Max clamp the final suggested price.
+	Min Dec
+	Max Dec
+}
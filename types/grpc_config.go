@@ -0,0 +1,26 @@
+package types
+
+import (
+	"crypto/tls"
+	"time"
+
+	"google.golang.org/grpc/keepalive"
+)
+
+// GRPCConfig describes one or more BSN gRPC endpoints a GRPCClient should dial, plus
+// the transport and auth options shared across them.
+type GRPCConfig struct {
+	// Endpoints is the list of BSN gRPC endpoints to pool connections across.
+	Endpoints []string
+	// TLS, when non-nil, dials every endpoint over TLS instead of plaintext.
+	TLS *tls.Config
+	// Keepalive configures the gRPC client keepalive parameters.
+	Keepalive keepalive.ClientParameters
+	// MaxRecvMsgSize bounds the largest message a connection will accept, 0 uses the grpc-go default.
+	MaxRecvMsgSize int
+	// BSNProjects maps an endpoint to the BSN project credentials used to authenticate requests against it.
+	BSNProjects map[string]BSNProjectInfo
+	// DialTimeout bounds how long to wait for an endpoint to finish connecting before
+	// trying the next one. 0 uses a default timeout.
+	DialTimeout time.Duration
+}